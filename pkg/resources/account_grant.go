@@ -37,6 +37,12 @@ var accountGrantSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Grants privilege to these roles.",
 	},
+	"shares": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Grants privilege to these shares.",
+	},
 	"with_grant_option": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -44,6 +50,12 @@ var accountGrantSchema = map[string]*schema.Schema{
 		Default:     false,
 		ForceNew:    true,
 	},
+	"authoritative": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, this resource manages the full set of grantees and revokes any granted outside Terraform.",
+	},
 }
 
 // AccountGrant returns a pointer to the resource representing an account grant
@@ -68,10 +80,12 @@ func AccountGrant() *TerraformGrantResource {
 func CreateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
+	roles := expandStringList(d.Get("roles").(*schema.Set).List())
+	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
 	builder := snowflake.AccountGrant()
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrantRolesAndShares(meta, builder, priv, grantOption, roles, shares)
 	if err != nil {
 		return err
 	}
@@ -137,8 +151,11 @@ func ReadAccountGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.AccountGrant()
 
+	authoritative := d.Get("authoritative").(bool)
 	tfRoles := expandStringList(d.Get("roles").(*schema.Set).List())
+	tfShares := expandStringList(d.Get("shares").(*schema.Set).List())
 	roles := make([]string, 0)
+	shares := make([]string, 0)
 
 	grants, err := readAccountGrants(meta.(*sql.DB), builder.Show())
 	if err != nil {
@@ -146,9 +163,32 @@ func ReadAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	for _, grant := range grants {
-		for _, tfRole := range tfRoles {
-			if tfRole == grant.GranteeName.String {
-				roles = append(roles, grant.GranteeName.String)
+		switch grant.GrantedTo.String {
+		case "ROLE":
+			// authoritative mode records every grantee of this privilege,
+			// not just ones declared in config.
+			if authoritative {
+				if grant.Privilege.String == grantID.Privilege {
+					roles = append(roles, grant.GranteeName.String)
+				}
+				continue
+			}
+			for _, tfRole := range tfRoles {
+				if tfRole == grant.GranteeName.String {
+					roles = append(roles, grant.GranteeName.String)
+				}
+			}
+		case "SHARE":
+			if authoritative {
+				if grant.Privilege.String == grantID.Privilege {
+					shares = append(shares, grant.GranteeName.String)
+				}
+				continue
+			}
+			for _, tfShare := range tfShares {
+				if tfShare == grant.GranteeName.String {
+					shares = append(shares, grant.GranteeName.String)
+				}
 			}
 		}
 	}
@@ -158,6 +198,11 @@ func ReadAccountGrant(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	err = d.Set("shares", shares)
+	if err != nil {
+		return err
+	}
+
 	return nil
 
 }
@@ -171,13 +216,21 @@ func DeleteAccountGrant(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateAccountGrant implements schema.UpdateFunc
 func UpdateAccountGrant(d *schema.ResourceData, meta interface{}) error {
-	// for now the only thing we can update is roles.
+	// for now the only thing we can update is roles and shares.
 	// if nothing changed, nothing to update and we're done.
-	if !d.HasChanges("roles") {
+	if !d.HasChanges("roles", "shares") {
 		return nil
 	}
 
 	rolesToAdd, rolesToRevoke := changeDiff(d, "roles")
+	sharesToAdd, sharesToRevoke := changeDiff(d, "shares")
+
+	// don't revoke grants that only became visible because authoritative was
+	// just toggled; let the next apply reconcile any real drift.
+	if d.HasChange("authoritative") {
+		rolesToRevoke = nil
+		sharesToRevoke = nil
+	}
 
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
@@ -187,13 +240,13 @@ func UpdateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	builder := snowflake.AccountGrant()
 
 	// first revoke
-	err = deleteGenericGrantRolesAndShares(meta, builder, grantID.Privilege, rolesToRevoke, nil)
+	err = deleteGenericGrantRolesAndShares(meta, builder, grantID.Privilege, rolesToRevoke, sharesToRevoke)
 	if err != nil {
 		return err
 	}
 
 	// then add
-	err = createGenericGrantRolesAndShares(meta, builder, grantID.Privilege, grantID.GrantOption, rolesToAdd, nil)
+	err = createGenericGrantRolesAndShares(meta, builder, grantID.Privilege, grantID.GrantOption, rolesToAdd, sharesToAdd)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,209 @@
+package resources_test
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// grantAccountPrivilegeToRole grants priv on the account directly to role,
+// bypassing Terraform, to simulate an out-of-band grant between plans.
+func grantAccountPrivilegeToRole(t *testing.T, priv, role string) {
+	t.Helper()
+
+	db := testAccProvider.Meta().(*sql.DB)
+	_, err := db.Exec(fmt.Sprintf(`GRANT %s ON ACCOUNT TO ROLE "%s"`, priv, role))
+	if err != nil {
+		t.Fatalf("failed to grant %s on account to role %s out-of-band: %s", priv, role, err)
+	}
+}
+
+// grantAccountPrivilegeToShare grants priv on the account directly to share,
+// bypassing Terraform, to simulate an out-of-band grant between plans.
+func grantAccountPrivilegeToShare(t *testing.T, priv, share string) {
+	t.Helper()
+
+	db := testAccProvider.Meta().(*sql.DB)
+	_, err := db.Exec(fmt.Sprintf(`GRANT %s ON ACCOUNT TO SHARE "%s"`, priv, share))
+	if err != nil {
+		t.Fatalf("failed to grant %s on account to share %s out-of-band: %s", priv, share, err)
+	}
+}
+
+func TestAcc_AccountGrantRoles(t *testing.T) {
+	role := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: accountGrantConfigRoles(role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "privilege", "MONITOR USAGE"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "roles.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "shares.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AccountGrantShares(t *testing.T) {
+	share := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: accountGrantConfigShares(share),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "privilege", "IMPORT SHARE"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "roles.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "shares.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AccountGrantRolesAndShares(t *testing.T) {
+	role := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	share := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: accountGrantConfigRolesAndShares(role, share),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "privilege", "MONITOR USAGE"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "roles.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "shares.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AccountGrantAuthoritative(t *testing.T) {
+	role := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	share := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	driftRole := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	driftShare := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	otherPrivRole := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: accountGrantConfigAuthoritative(role, share, driftRole, driftShare, otherPrivRole),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "authoritative", "true"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "roles.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "shares.#", "1"),
+				),
+			},
+			{
+				// simulate grants applied outside of Terraform, plus an
+				// unrelated account privilege granted to a different role, and
+				// plan (without applying) to assert the drift is detected but
+				// not yet reconciled.
+				PreConfig: func() {
+					grantAccountPrivilegeToRole(t, "MONITOR USAGE", driftRole)
+					grantAccountPrivilegeToShare(t, "MONITOR USAGE", driftShare)
+					grantAccountPrivilegeToRole(t, "CREATE USER", otherPrivRole)
+				},
+				Config: accountGrantConfigAuthoritative(role, share, driftRole, driftShare, otherPrivRole),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "roles.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_account_grant.g", "shares.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_account_grant.g", "roles.*", driftRole),
+					resource.TestCheckTypeSetElemAttr("snowflake_account_grant.g", "shares.*", driftShare),
+				),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func accountGrantConfigRoles(role string) string {
+	return fmt.Sprintf(`
+resource "snowflake_role" "r" {
+  name = "%s"
+}
+
+resource "snowflake_account_grant" "g" {
+  privilege = "MONITOR USAGE"
+  roles     = [snowflake_role.r.name]
+}
+`, role)
+}
+
+func accountGrantConfigShares(share string) string {
+	return fmt.Sprintf(`
+resource "snowflake_share" "s" {
+  name = "%s"
+}
+
+resource "snowflake_account_grant" "g" {
+  privilege = "IMPORT SHARE"
+  shares    = [snowflake_share.s.name]
+}
+`, share)
+}
+
+func accountGrantConfigRolesAndShares(role, share string) string {
+	return fmt.Sprintf(`
+resource "snowflake_role" "r" {
+  name = "%s"
+}
+
+resource "snowflake_share" "s" {
+  name = "%s"
+}
+
+resource "snowflake_account_grant" "g" {
+  privilege = "MONITOR USAGE"
+  roles     = [snowflake_role.r.name]
+  shares    = [snowflake_share.s.name]
+}
+`, role, share)
+}
+
+func accountGrantConfigAuthoritative(role, share, driftRole, driftShare, otherPrivRole string) string {
+	return fmt.Sprintf(`
+resource "snowflake_role" "r" {
+  name = "%s"
+}
+
+resource "snowflake_share" "s" {
+  name = "%s"
+}
+
+// created so the out-of-band grants in the test's PreConfig have a role/share
+// to target; none of these are declared in snowflake_account_grant.g
+resource "snowflake_role" "drift" {
+  name = "%s"
+}
+
+resource "snowflake_share" "drift" {
+  name = "%s"
+}
+
+resource "snowflake_role" "other_priv" {
+  name = "%s"
+}
+
+resource "snowflake_account_grant" "g" {
+  privilege     = "MONITOR USAGE"
+  roles         = [snowflake_role.r.name]
+  shares        = [snowflake_share.s.name]
+  authoritative = true
+}
+`, role, share, driftRole, driftShare, otherPrivRole)
+}